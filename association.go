@@ -0,0 +1,70 @@
+package gorm
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Association is a typed handle onto one of M's has-many, has-one,
+// belongs-to, or many-to-many relations, wrapping gorm.DB.Association so
+// callers don't have to drop down to Scope to touch them.
+type Association[M any] struct {
+	db   *gorm.DB
+	name string
+}
+
+// Association returns a handle onto the named association for the rows
+// matched by container's current conditions.
+func (container DB[M]) Association(name string) *Association[M] {
+	return &Association[M]{db: container.db, name: name}
+}
+
+func (a *Association[M]) assocFor(ctx context.Context) *gorm.Association {
+	return a.db.WithContext(ctx).Association(a.name)
+}
+
+// Append adds values to the association without replacing existing ones.
+func (a *Association[M]) Append(ctx context.Context, values ...any) error {
+	return a.assocFor(ctx).Append(values...)
+}
+
+// Replace replaces the association's current contents with values.
+func (a *Association[M]) Replace(ctx context.Context, values ...any) error {
+	return a.assocFor(ctx).Replace(values...)
+}
+
+// Delete removes values from the association.
+func (a *Association[M]) Delete(ctx context.Context, values ...any) error {
+	return a.assocFor(ctx).Delete(values...)
+}
+
+// Clear removes all references to the association.
+func (a *Association[M]) Clear(ctx context.Context) error {
+	return a.assocFor(ctx).Clear()
+}
+
+// Count returns the number of associated rows.
+func (a *Association[M]) Count(ctx context.Context) (int64, error) {
+	assoc := a.assocFor(ctx)
+	count := assoc.Count()
+	return count, assoc.Error
+}
+
+// Find loads the association into out, optionally narrowed by conds.
+func (a *Association[M]) Find(ctx context.Context, out any, conds ...any) error {
+	return a.assocFor(ctx).Find(out, conds...)
+}
+
+// Preload eagerly loads assoc alongside the next finisher call.
+func (container DB[M]) Preload(assoc string, args ...any) DB[M] {
+	container.db = container.db.Preload(assoc, args...)
+	return container
+}
+
+// JoinsPreload performs an inner/left join on assoc and eagerly loads it in
+// the same query, avoiding the N+1 round trip Preload alone would cause.
+func (container DB[M]) JoinsPreload(assoc string, args ...any) DB[M] {
+	container.db = container.db.Joins(assoc, args...)
+	return container
+}
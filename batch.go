@@ -0,0 +1,74 @@
+package gorm
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+)
+
+// WithCreateBatchSize sets the batch size used by CreateInBatches and
+// UpsertInBatches. It has no effect on Create/Upsert, which issue a single
+// statement regardless of size.
+func WithCreateBatchSize(n int) DBOption {
+	return func(opts *dbOptions) {
+		opts.createBatchSize = n
+	}
+}
+
+// CreateInBatches slices instances into chunks of batchSize (or the size
+// configured via WithCreateBatchSize if batchSize is 0) and issues a
+// sequential Create for each chunk under one transaction, so the driver's
+// placeholder limit is never exceeded by one large insert. If container is
+// already inside a transaction (Begin was called, or this runs inside
+// another Transaction closure), Transaction reuses it via a savepoint
+// instead of starting a new one.
+func (container DB[M]) CreateInBatches(ctx context.Context, instances []M, batchSize int) error {
+	batchSize = container.resolveBatchSize(batchSize, len(instances))
+
+	return container.Transaction(ctx, func(tx DB[M]) error {
+		for start := 0; start < len(instances); start += batchSize {
+			end := start + batchSize
+			if end > len(instances) {
+				end = len(instances)
+			}
+
+			if err := tx.Create(ctx, instances[start:end]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpsertInBatches is CreateInBatches with an ON CONFLICT clause applied to
+// every batch.
+func (container DB[M]) UpsertInBatches(ctx context.Context, instances []M, onConflict clause.OnConflict, batchSize int) error {
+	batchSize = container.resolveBatchSize(batchSize, len(instances))
+
+	return container.Transaction(ctx, func(tx DB[M]) error {
+		for start := 0; start < len(instances); start += batchSize {
+			end := start + batchSize
+			if end > len(instances) {
+				end = len(instances)
+			}
+
+			if err := tx.Upsert(ctx, instances[start:end], onConflict); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (container DB[M]) resolveBatchSize(batchSize, total int) int {
+	if batchSize <= 0 {
+		batchSize = container.createBatchSize
+	}
+	if batchSize <= 0 {
+		batchSize = total
+	}
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return batchSize
+}
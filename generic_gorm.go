@@ -11,16 +11,42 @@ import (
 
 // where M is a gorm model
 type DB[M any] struct {
-	db      *gorm.DB
-	timeout time.Duration
+	db              *gorm.DB
+	timeout         time.Duration
+	createBatchSize int
+	hooks           Hooks
 }
 
-func NewDB[M any](db *gorm.DB) DB[M] {
-	return DB[M]{db: db.Model(new(M))}
+// DBOption configures a DB[M] at construction time via NewDB or
+// NewDBWithTimeout.
+type DBOption func(*dbOptions)
+
+type dbOptions struct {
+	createBatchSize int
+}
+
+func parseDBOptions(opts ...DBOption) (res dbOptions) {
+	for _, opt := range opts {
+		opt(&res)
+	}
+	return
 }
 
-func NewDBWithTimeout[M any](db *gorm.DB, timeOut time.Duration) DB[M] {
-	return DB[M]{db: db.Model(new(M)), timeout: timeOut}
+func NewDB[M any](db *gorm.DB, opts ...DBOption) DB[M] {
+	return DB[M]{db: db.Model(new(M)), createBatchSize: parseDBOptions(opts...).createBatchSize}
+}
+
+func NewDBWithTimeout[M any](db *gorm.DB, timeOut time.Duration, opts ...DBOption) DB[M] {
+	return DB[M]{db: db.Model(new(M)), timeout: timeOut, createBatchSize: parseDBOptions(opts...).createBatchSize}
+}
+
+// NewDBWithHooks builds a DB[M] that reports timing and outcome of Find,
+// Take, Count, Create, Updates, Delete, Upsert, SoftDelete and Restore (and
+// the helpers built on them) through h, so callers can plug in a logger,
+// metrics, or tracing without installing a GORM plugin. FindPage, FindEach,
+// FindChan and the Association methods are not reported.
+func NewDBWithHooks[M any](db *gorm.DB, h Hooks) DB[M] {
+	return DB[M]{db: db.Model(new(M)), hooks: h}
 }
 
 func (container DB[M]) Begin(opts ...*sql.TxOptions) DB[M] {
@@ -43,17 +69,22 @@ func (container DB[M]) Create(ctx context.Context, instances []M) error {
 		defer cancel()
 	}
 
-	return container.db.WithContext(ctx).Create(&instances).Error
+	res := container.runHooked(ctx, "Create", func(db *gorm.DB) *gorm.DB {
+		return db.Create(&instances)
+	})
+	return res.Error
 }
 
-func (container DB[M]) Delete(ctx context.Context, condition M) (rawsAffected int64, err error) {
+func (container DB[M]) Delete(ctx context.Context, condition M, opts ...Option) (rawsAffected int64, err error) {
 	if container.timeout != 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, container.timeout)
 		defer cancel()
 	}
 
-	res := container.db.WithContext(ctx).Delete(&condition)
+	res := container.runHooked(ctx, "Delete", func(db *gorm.DB) *gorm.DB {
+		return applyOptions(db, opts...).Delete(&condition)
+	})
 	return res.RowsAffected, res.Error
 }
 
@@ -67,47 +98,55 @@ func (container DB[M]) WhereRaw(condition string, args ...interface{}) DB[M] {
 	return container
 }
 
-func (container DB[M]) Find(ctx context.Context) (result []M, err error) {
+func (container DB[M]) Find(ctx context.Context, opts ...Option) (result []M, err error) {
 	if container.timeout != 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, container.timeout)
 		defer cancel()
 	}
 
-	err = container.db.WithContext(ctx).Find(&result).Error
+	err = container.runHooked(ctx, "Find", func(db *gorm.DB) *gorm.DB {
+		return applyOptions(db, opts...).Find(&result)
+	}).Error
 	return
 }
 
-func (container DB[M]) Take(ctx context.Context) (result M, err error) {
+func (container DB[M]) Take(ctx context.Context, opts ...Option) (result M, err error) {
 	if container.timeout != 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, container.timeout)
 		defer cancel()
 	}
 
-	err = container.db.WithContext(ctx).Take(&result).Error
+	err = container.runHooked(ctx, "Take", func(db *gorm.DB) *gorm.DB {
+		return applyOptions(db, opts...).Take(&result)
+	}).Error
 	return
 }
 
-func (container DB[M]) Count(ctx context.Context) (result int64, err error) {
+func (container DB[M]) Count(ctx context.Context, opts ...Option) (result int64, err error) {
 	if container.timeout != 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, container.timeout)
 		defer cancel()
 	}
 
-	err = container.db.WithContext(ctx).Count(&result).Error
+	err = container.runHooked(ctx, "Count", func(db *gorm.DB) *gorm.DB {
+		return applyOptions(db, opts...).Count(&result)
+	}).Error
 	return
 }
 
-func (container DB[M]) Updates(ctx context.Context, instance M) (rowsAffected int64, err error) {
+func (container DB[M]) Updates(ctx context.Context, instance M, opts ...Option) (rowsAffected int64, err error) {
 	if container.timeout != 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, container.timeout)
 		defer cancel()
 	}
 
-	res := container.db.WithContext(ctx).Updates(&instance)
+	res := container.runHooked(ctx, "Updates", func(db *gorm.DB) *gorm.DB {
+		return applyOptions(db, opts...).Updates(&instance)
+	})
 	return res.RowsAffected, res.Error
 }
 
@@ -158,7 +197,10 @@ func (container DB[M]) Upsert(ctx context.Context, instances []M, clause clause.
 		defer cancel()
 	}
 
-	return container.db.WithContext(ctx).Clauses(clause).Create(&instances).Error
+	res := container.runHooked(ctx, "Upsert", func(db *gorm.DB) *gorm.DB {
+		return db.Clauses(clause).Create(&instances)
+	})
+	return res.Error
 }
 
 func (container DB[M]) Joins(query string, args ...interface{}) DB[M] {
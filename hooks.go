@@ -0,0 +1,106 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Hooks lets callers observe finisher calls that go through runHooked (Find,
+// Take, Count, Create, Updates, Delete, Upsert, SoftDelete, Restore) without
+// installing a GORM plugin. BeforeQuery fires before exec runs, so hooks like WithOTelTracer
+// can genuinely bracket the call's wall-clock time; the generated SQL isn't
+// known yet at that point, so it's only available to AfterQuery and OnSlow,
+// both of which fire once exec has completed. BeforeQuery may return a
+// correlation token that is handed back to AfterQuery, so hooks that need to
+// tie the two calls together (e.g. a span started in BeforeQuery and ended
+// in AfterQuery) don't have to correlate by ctx identity, which breaks when
+// a ctx is shared across concurrent or sequential calls. OnSlow additionally
+// fires when the call took at least SlowThreshold.
+type Hooks struct {
+	BeforeQuery   func(ctx context.Context, op string) (token any)
+	AfterQuery    func(ctx context.Context, op, sql string, rowsAffected int64, err error, dur time.Duration, token any)
+	SlowThreshold time.Duration
+	OnSlow        func(ctx context.Context, sql string, dur time.Duration)
+}
+
+// sqlCapture wraps a gorm logger.Interface to record the rendered SQL of
+// the query it traces. GORM resets Statement.SQL to empty immediately after
+// a real (non-dry-run) exec, so this is the only point at which the
+// generated SQL is observable post-execution; it still delegates to the
+// wrapped logger so the usual slow-query/error logging behavior is kept.
+type sqlCapture struct {
+	logger.Interface
+	sql string
+}
+
+func (c *sqlCapture) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	c.sql, _ = fc()
+	if c.Interface != nil {
+		c.Interface.Trace(ctx, begin, fc, err)
+	}
+}
+
+// runHooked executes exec exactly once against container's underlying
+// *gorm.DB (scoped to ctx), timing the call and reporting it through
+// container.hooks.
+func (container DB[M]) runHooked(ctx context.Context, op string, exec func(db *gorm.DB) *gorm.DB) *gorm.DB {
+	capture := &sqlCapture{Interface: container.db.Logger}
+	db := container.db.Session(&gorm.Session{Logger: capture}).WithContext(ctx)
+
+	var token any
+	if container.hooks.BeforeQuery != nil {
+		token = container.hooks.BeforeQuery(ctx, op)
+	}
+
+	start := time.Now()
+	res := exec(db)
+	dur := time.Since(start)
+
+	if container.hooks.AfterQuery != nil {
+		container.hooks.AfterQuery(ctx, op, capture.sql, res.RowsAffected, res.Error, dur, token)
+	}
+
+	if container.hooks.OnSlow != nil && container.hooks.SlowThreshold > 0 && dur >= container.hooks.SlowThreshold {
+		container.hooks.OnSlow(ctx, capture.sql, dur)
+	}
+
+	return res
+}
+
+// WithOTelTracer builds a Hooks value that emits one span per query on
+// tracer, recording the operation name, statement, row count, and error
+// status. The span is created in BeforeQuery and returned as the
+// correlation token, so its own start/end timestamps bracket the real
+// exec call and AfterQuery ends the exact span that started it, regardless
+// of how many queries share a ctx concurrently.
+func WithOTelTracer(tracer trace.Tracer) Hooks {
+	return Hooks{
+		BeforeQuery: func(ctx context.Context, op string) any {
+			_, span := tracer.Start(ctx, fmt.Sprintf("gorm.%s", op))
+			return span
+		},
+		AfterQuery: func(ctx context.Context, op, sql string, rowsAffected int64, err error, dur time.Duration, token any) {
+			span, ok := token.(trace.Span)
+			if !ok {
+				return
+			}
+
+			span.SetAttributes(
+				attribute.String("db.statement", sql),
+				attribute.Int64("db.rows_affected", rowsAffected),
+				attribute.String("db.duration", dur.String()),
+			)
+			if err != nil {
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package gorm
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Paginate applies page/pageSize offset pagination on top of Limit/Offset.
+// page is 1-indexed; values below 1 are treated as page 1.
+func (container DB[M]) Paginate(page, pageSize int) DB[M] {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		return container
+	}
+
+	container.db = container.db.Limit(pageSize).Offset((page - 1) * pageSize)
+	return container
+}
+
+// PaginateCursor applies keyset pagination on column, scanning forward from
+// lastValue in the given order. For Asc this translates to
+// `WHERE column > ? ORDER BY column ASC LIMIT ?`, and for Desc the
+// comparison and ordering are reversed.
+func (container DB[M]) PaginateCursor(column string, lastValue any, pageSize int, order Order) DB[M] {
+	op := ">"
+	if order == Desc {
+		op = "<"
+	}
+
+	container.db = container.db.Where(column+" "+op+" ?", lastValue)
+	return container.OrderBy(column, order).Limit(pageSize)
+}
+
+// FindPage runs Count and Find in a single call, returning the page of
+// items alongside the total row count matching the current conditions. The
+// count query runs against a clone with Limit/Offset reset, since gorm's
+// Count only strips ORDER BY and would otherwise return 0 on any page past
+// the first.
+func (container DB[M]) FindPage(ctx context.Context) (items []M, total int64, err error) {
+	if container.timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, container.timeout)
+		defer cancel()
+	}
+
+	countDB := container.db.Session(&gorm.Session{}).Offset(-1).Limit(-1)
+	if err = countDB.WithContext(ctx).Count(&total).Error; err != nil {
+		return
+	}
+
+	err = container.db.WithContext(ctx).Find(&items).Error
+	return
+}
@@ -0,0 +1,104 @@
+package gorm
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Option is a reusable, named query filter that can be composed and passed
+// to Query or any finisher (Find, Take, Count, Updates, Delete) instead of
+// chaining raw WhereRaw calls.
+type Option func(*gorm.DB) *gorm.DB
+
+// Query applies opts to container in order and returns the resulting DB[M],
+// so filters compose the same way Where/Joins/Order do.
+func (container DB[M]) Query(opts ...Option) DB[M] {
+	container.db = applyOptions(container.db, opts...)
+	return container
+}
+
+func applyOptions(db *gorm.DB, opts ...Option) *gorm.DB {
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db
+}
+
+// WithID filters rows whose id column equals id.
+func WithID(id any) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("id = ?", id)
+	}
+}
+
+// WithIDs filters rows whose id column is in ids.
+func WithIDs(ids []any) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("id IN ?", ids)
+	}
+}
+
+// WithLike filters rows where col matches the SQL LIKE pattern.
+func WithLike(col, pattern string) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(col+" LIKE ?", pattern)
+	}
+}
+
+// WithIn filters rows where col is one of vals.
+func WithIn(col string, vals []any) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(col+" IN ?", vals)
+	}
+}
+
+// WithBetween filters rows where col falls between lo and hi, inclusive.
+func WithBetween(col string, lo, hi any) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(col+" BETWEEN ? AND ?", lo, hi)
+	}
+}
+
+// WithDateRange filters rows where col falls within [from, to], inclusive.
+func WithDateRange(col string, from, to time.Time) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(col+" BETWEEN ? AND ?", from, to)
+	}
+}
+
+// WithGroupBy groups results by cols.
+func WithGroupBy(cols ...string) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, col := range cols {
+			db = db.Group(col)
+		}
+		return db
+	}
+}
+
+// WithHaving filters grouped results by expr.
+func WithHaving(expr string, args ...any) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Having(expr, args...)
+	}
+}
+
+// WithSelect restricts the columns returned by the query.
+func WithSelect(cols ...string) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Select(cols)
+	}
+}
+
+// WithPreload eagerly loads assoc, applying opts to the preload's own scope.
+func WithPreload(assoc string, opts ...Option) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(opts) == 0 {
+			return db.Preload(assoc)
+		}
+		return db.Preload(assoc, func(tx *gorm.DB) *gorm.DB {
+			return applyOptions(tx, opts...)
+		})
+	}
+}
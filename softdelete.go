@@ -0,0 +1,71 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Model is a convenience base for models that want the usual id/timestamp
+// columns plus soft-delete support; embed it the way gorm.Model is embedded
+// in plain GORM code.
+type Model struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// softDeletable is implemented by Model so WithSoftDelete can recognize
+// models that carry a DeletedAt column at compile time.
+type softDeletable interface {
+	softDeleteMarker()
+}
+
+func (Model) softDeleteMarker() {}
+
+// WithSoftDelete constrains M to models embedding Model (or otherwise
+// implementing softDeletable), so SoftDelete/Restore refuse to compile
+// against models that have no DeletedAt column.
+type WithSoftDelete[M any] interface {
+	*M
+	softDeletable
+}
+
+// Unscoped includes soft-deleted rows in the next query or delete, mirroring
+// gorm.DB.Unscoped.
+func (container DB[M]) Unscoped() DB[M] {
+	container.db = container.db.Unscoped()
+	return container
+}
+
+// SoftDelete sets DeletedAt on rows matching condition without needing
+// Unscoped; it is Delete narrowed to models that actually support it.
+func SoftDelete[M any, PM WithSoftDelete[M]](container DB[M], ctx context.Context, condition M) (rowsAffected int64, err error) {
+	if container.timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, container.timeout)
+		defer cancel()
+	}
+
+	res := container.runHooked(ctx, "SoftDelete", func(db *gorm.DB) *gorm.DB {
+		return db.Delete(&condition)
+	})
+	return res.RowsAffected, res.Error
+}
+
+// Restore clears DeletedAt on rows matching condition, undoing a prior
+// SoftDelete/Delete.
+func Restore[M any, PM WithSoftDelete[M]](container DB[M], ctx context.Context, condition M) (rowsAffected int64, err error) {
+	if container.timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, container.timeout)
+		defer cancel()
+	}
+
+	res := container.runHooked(ctx, "Restore", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped().Where(&condition).Update("deleted_at", nil)
+	})
+	return res.RowsAffected, res.Error
+}
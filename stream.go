@@ -0,0 +1,66 @@
+package gorm
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// FindEach streams the result set in chunks of batchSize via
+// gorm.DB.FindInBatches, invoking fn once per row. fn returning a non-nil
+// error stops iteration and is propagated to the caller, so large tables can
+// be processed without materializing the whole slice into memory.
+func (container DB[M]) FindEach(ctx context.Context, batchSize int, fn func(M) error) error {
+	if container.timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, container.timeout)
+		defer cancel()
+	}
+
+	var batch []M
+	var fnErr error
+
+	err := container.db.WithContext(ctx).FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		for _, item := range batch {
+			if fnErr = fn(item); fnErr != nil {
+				return fnErr
+			}
+		}
+		return nil
+	}).Error
+	if fnErr != nil {
+		return fnErr
+	}
+	return err
+}
+
+// FindChan streams the result set in chunks of batchSize onto a channel of
+// individual rows, so callers can range over results as they arrive instead
+// of waiting on the full Find. Both channels are closed once iteration
+// completes, ctx is canceled, or a batch fails.
+func (container DB[M]) FindChan(ctx context.Context, batchSize int) (<-chan M, <-chan error) {
+	items := make(chan M)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(items)
+		defer close(errs)
+
+		var batch []M
+		err := container.db.WithContext(ctx).FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			for _, item := range batch {
+				select {
+				case items <- item:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			return nil
+		}).Error
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return items, errs
+}
@@ -0,0 +1,69 @@
+package gorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Transaction begins a transaction, calls fn with a DB[M] scoped to it, and
+// commits on a nil return or rolls back on error. A panic inside fn also
+// triggers a rollback before being re-panicked, mirroring gorm.DB.Transaction
+// while preserving the generic model type. If container is already inside a
+// transaction (e.g. a nested Transaction call, or a manual Begin), a
+// savepoint is used instead of starting a new transaction, the same way
+// gorm.DB.Transaction itself nests.
+func (container DB[M]) Transaction(ctx context.Context, fn func(tx DB[M]) error, opts ...*sql.TxOptions) (err error) {
+	if _, ok := container.db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		spName := fmt.Sprintf("sp%p", fn)
+		tx := container
+		if err = tx.SavePoint(spName); err != nil {
+			return err
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				tx.RollbackTo(spName)
+				panic(r)
+			}
+		}()
+
+		if err = fn(tx); err != nil {
+			tx.RollbackTo(spName)
+			return err
+		}
+
+		return nil
+	}
+
+	tx := container.Begin(opts...)
+	if tx.db.Error != nil {
+		return tx.db.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.RollBack()
+			panic(r)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		tx.RollBack()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SavePoint creates a named savepoint within the current transaction.
+func (container DB[M]) SavePoint(name string) error {
+	return container.db.SavePoint(name).Error
+}
+
+// RollbackTo rolls the current transaction back to the named savepoint.
+func (container DB[M]) RollbackTo(name string) error {
+	return container.db.RollbackTo(name).Error
+}